@@ -0,0 +1,83 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"goa.design/goa/expr"
+)
+
+// CompositionValidationCode returns the Go statement validating that the
+// bytes held by varName unmarshal against c's branches according to its
+// Kind: exactly one branch must match for OneOf, at least one for AnyOf, all
+// of them for AllOf, and none for Not.
+func CompositionValidationCode(varName string, c *expr.CompositionExpr) string {
+	switch c.Kind {
+	case expr.OneOfComposition:
+		return matchCountCode(varName, c.Types, "== 1", "oneOf")
+	case expr.AnyOfComposition:
+		return matchCountCode(varName, c.Types, ">= 1", "anyOf")
+	case expr.AllOfComposition:
+		return matchCountCode(varName, c.Types, fmt.Sprintf("== %d", len(c.Types)), "allOf")
+	case expr.NotComposition:
+		return fmt.Sprintf(
+			`if validate%s(%s) == nil {
+	err = &goa.ValidationError{Kind: "not", Field: %q}
+}`, branchName(c.Types[0]), varName, varName)
+	}
+	return ""
+}
+
+// matchCountCode generates the shared unmarshal-and-count-matches logic used
+// by OneOf, AnyOf and AllOf: it attempts to validate varName against every
+// branch type and checks that the number of branches that matched satisfies
+// cond (e.g. "== 1" for OneOf). kind is the goa.ValidationError Kind to
+// report on failure ("oneOf", "anyOf" or "allOf").
+func matchCountCode(varName string, types []expr.DataType, cond, kind string) string {
+	var checks []string
+	for _, t := range types {
+		checks = append(checks, fmt.Sprintf("if validate%s(%s) == nil {\n\tmatches++\n}", branchName(t), varName))
+	}
+	return fmt.Sprintf(
+		`{
+	matches := 0
+	%s
+	if !(matches %s) {
+		err = &goa.ValidationError{Kind: %q, Field: %q, Params: map[string]interface{}{"matches": matches}}
+	}
+}`, strings.Join(checks, "\n\t"), cond, kind, varName)
+}
+
+// branchName derives a Go identifier suffix from a branch's type name, used
+// to name the per-branch validation function referenced by the generated
+// code (e.g. validateAccountPayload).
+func branchName(t expr.DataType) string {
+	name := t.Name()
+	var b strings.Builder
+	upperNext := true
+	for _, r := range name {
+		if r == '_' || r == '-' || r == ' ' {
+			upperNext = true
+			continue
+		}
+		if upperNext {
+			b.WriteString(strings.ToUpper(string(r)))
+			upperNext = false
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// TaggedUnionStruct returns the Go source of the tagged-union struct
+// generated for an attribute carrying a OneOf composition: one pointer field
+// per branch so that exactly one can be non-nil at a time, giving handlers a
+// typed view of the discriminated payload.
+func TaggedUnionStruct(name string, c *expr.CompositionExpr) string {
+	var fields []string
+	for _, t := range c.Types {
+		fields = append(fields, fmt.Sprintf("\t%s *%s", branchName(t), branchName(t)))
+	}
+	return fmt.Sprintf("type %s struct {\n%s\n}", name, strings.Join(fields, "\n"))
+}
@@ -0,0 +1,54 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+type namedType string
+
+func (n namedType) Kind() expr.Kind                  { return expr.ObjectKind }
+func (n namedType) Name() string                     { return string(n) }
+func (n namedType) IsCompatible(val interface{}) bool { return true }
+func (n namedType) Dup() expr.DataType                { return n }
+
+func TestCompositionValidationCode(t *testing.T) {
+	cases := []struct {
+		kind expr.CompositionKind
+		want string
+	}{
+		{expr.OneOfComposition, "matches == 1"},
+		{expr.AnyOfComposition, "matches >= 1"},
+		{expr.AllOfComposition, "matches == 2"},
+	}
+	for _, c := range cases {
+		comp := &expr.CompositionExpr{Kind: c.kind, Types: []expr.DataType{namedType("card_payment"), namedType("bank_transfer")}}
+		code := CompositionValidationCode("body", comp)
+		if !strings.Contains(code, c.want) {
+			t.Errorf("kind %v: expected code to contain %q, got:\n%s", c.kind, c.want, code)
+		}
+		if !strings.Contains(code, "validateCardPayment(body)") {
+			t.Errorf("kind %v: expected a call to validateCardPayment, got:\n%s", c.kind, code)
+		}
+	}
+}
+
+func TestCompositionValidationCodeNot(t *testing.T) {
+	comp := &expr.CompositionExpr{Kind: expr.NotComposition, Types: []expr.DataType{namedType("legacy_payload")}}
+	code := CompositionValidationCode("body", comp)
+	if !strings.Contains(code, "validateLegacyPayload(body) == nil") {
+		t.Errorf("expected Not to check the excluded branch, got:\n%s", code)
+	}
+}
+
+func TestTaggedUnionStruct(t *testing.T) {
+	comp := &expr.CompositionExpr{Kind: expr.OneOfComposition, Types: []expr.DataType{namedType("card_payment"), namedType("bank_transfer")}}
+	src := TaggedUnionStruct("Payment", comp)
+	for _, want := range []string{"type Payment struct", "CardPayment *CardPayment", "BankTransfer *BankTransfer"} {
+		if !strings.Contains(src, want) {
+			t.Errorf("expected struct source to contain %q, got:\n%s", want, src)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package codegen
+
+import "fmt"
+
+// ConditionalValidationCode returns the Go statement implementing the
+// straight-line if/then/else validation generated for a Conditionals entry:
+// it calls predicateFunc to evaluate the predicate against varName and
+// dispatches to thenFunc or elseFunc accordingly, accumulating any error
+// returned under the same JSON-pointer path as varName. elseFunc may be
+// empty if the conditional has no Else block.
+func ConditionalValidationCode(varName, predicateFunc, thenFunc, elseFunc string) string {
+	if elseFunc == "" {
+		return fmt.Sprintf(
+			`if %s(%s) {
+	if verr := %s(%s); verr != nil {
+		err = errors.Join(err, verr)
+	}
+}`, predicateFunc, varName, thenFunc, varName)
+	}
+	return fmt.Sprintf(
+		`if %s(%s) {
+	if verr := %s(%s); verr != nil {
+		err = errors.Join(err, verr)
+	}
+} else {
+	if verr := %s(%s); verr != nil {
+		err = errors.Join(err, verr)
+	}
+}`, predicateFunc, varName, thenFunc, varName, elseFunc, varName)
+}
@@ -0,0 +1,28 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConditionalValidationCode(t *testing.T) {
+	t.Run("with Else", func(t *testing.T) {
+		code := ConditionalValidationCode("body", "predicateKind", "thenTarget", "elseTarget")
+		if !strings.Contains(code, "if predicateKind(body) {") {
+			t.Errorf("expected predicate call, got:\n%s", code)
+		}
+		if !strings.Contains(code, "thenTarget(body)") || !strings.Contains(code, "elseTarget(body)") {
+			t.Errorf("expected both Then and Else branches, got:\n%s", code)
+		}
+	})
+
+	t.Run("without Else", func(t *testing.T) {
+		code := ConditionalValidationCode("body", "predicateKind", "thenTarget", "")
+		if strings.Contains(code, "else") {
+			t.Errorf("expected no else branch, got:\n%s", code)
+		}
+		if !strings.Contains(code, "thenTarget(body)") {
+			t.Errorf("expected Then branch, got:\n%s", code)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package codegen
+
+import (
+	"fmt"
+
+	"goa.design/goa/expr"
+)
+
+// FormatValidationCode returns the Go statement validating that the string
+// held by varName matches format. For a custom format registered via
+// dsl.RegisterFormat it calls the configured GoSymbol; for a built-in format
+// it falls back to the regexp/time based check goa ships.
+func FormatValidationCode(varName string, format expr.ValidationFormat) string {
+	if cfg := expr.LookupFormat(format); cfg != nil {
+		if cfg.GoSymbol == "" {
+			return fmt.Sprintf("// WARNING: format %q has no Go symbol configured via WithGoSymbol, skipping validation", format)
+		}
+		return fmt.Sprintf(
+			`if !%s(%s) {
+	err = &goa.ValidationError{Kind: "format", Field: %q, Params: map[string]interface{}{"format": %q}}
+}`, cfg.GoSymbol, varName, varName, format)
+	}
+	return fmt.Sprintf(
+		`if !goa.ValidateFormat(%s, %q) {
+	err = &goa.ValidationError{Kind: "format", Field: %q, Params: map[string]interface{}{"format": %q}}
+}`, varName, format, varName, format)
+}
@@ -0,0 +1,36 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+func TestFormatValidationCodeBuiltin(t *testing.T) {
+	code := FormatValidationCode("body.Email", expr.FormatEmail)
+	if !strings.Contains(code, `goa.ValidateFormat(body.Email, "email")`) {
+		t.Errorf("expected builtin format check, got:\n%s", code)
+	}
+}
+
+func TestFormatValidationCodeCustom(t *testing.T) {
+	expr.RegisterFormat("format-validation-code-custom", &expr.FormatConfig{
+		Checker:  func(string) bool { return true },
+		GoSymbol: "formats.ValidateIBAN",
+	})
+	code := FormatValidationCode("body.IBAN", "format-validation-code-custom")
+	if !strings.Contains(code, "formats.ValidateIBAN(body.IBAN)") {
+		t.Errorf("expected call to the registered GoSymbol, got:\n%s", code)
+	}
+}
+
+func TestFormatValidationCodeCustomWithoutGoSymbol(t *testing.T) {
+	expr.RegisterFormat("format-validation-code-no-symbol", &expr.FormatConfig{
+		Checker: func(string) bool { return true },
+	})
+	code := FormatValidationCode("body.Value", "format-validation-code-no-symbol")
+	if !strings.Contains(code, "WARNING") {
+		t.Errorf("expected a warning comment when GoSymbol is unset, got:\n%s", code)
+	}
+}
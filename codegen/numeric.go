@@ -0,0 +1,40 @@
+// Package codegen generates the Go source that implements the validations
+// attached to attributes via the dsl package.
+package codegen
+
+import "fmt"
+
+// multipleOfEpsilon is the tolerance used when comparing a float64 against
+// the nearest multiple, to absorb floating point rounding error.
+const multipleOfEpsilon = 1e-9
+
+// MultipleOfCode returns the Go statement checking that the value held by
+// varName is a multiple of multiple, as attached via dsl.MultipleOf. On
+// failure it assigns a *goa.ValidationError to err rather than an opaque
+// fmt.Errorf string, so callers can render or translate the message via
+// goa.ValidationError.Localize.
+func MultipleOfCode(varName string, multiple float64) string {
+	return fmt.Sprintf(
+		`if rem := math.Abs(math.Mod(float64(%s), %v)); rem > %v && rem < %v-%v {
+	err = &goa.ValidationError{Kind: "multipleOf", Field: %q, Params: map[string]interface{}{"multipleOf": %v}}
+}`, varName, multiple, multipleOfEpsilon, multiple, multipleOfEpsilon, varName, multiple)
+}
+
+// UniqueItemsCode returns the Go statement checking that the slice held by
+// varName has no duplicate elements, as attached via dsl.UniqueItems. The
+// check is O(n): it walks the slice once, recording each element's formatted
+// representation in a set.
+func UniqueItemsCode(varName string) string {
+	return fmt.Sprintf(
+		`{
+	seen := make(map[string]struct{}, len(%s))
+	for _, v := range %s {
+		key := fmt.Sprintf("%%v", v)
+		if _, ok := seen[key]; ok {
+			err = &goa.ValidationError{Kind: "uniqueItems", Field: %q}
+			break
+		}
+		seen[key] = struct{}{}
+	}
+}`, varName, varName, varName)
+}
@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMultipleOfCode(t *testing.T) {
+	code := MultipleOfCode("body.Count", 5)
+	for _, want := range []string{
+		"math.Mod(float64(body.Count), 5)", "rem > 1e-09", "rem < 5-1e-09",
+		`Kind: "multipleOf"`, `Field: "body.Count"`, `"multipleOf": 5`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q, got:\n%s", want, code)
+		}
+	}
+}
+
+func TestUniqueItemsCode(t *testing.T) {
+	code := UniqueItemsCode("body.Tags")
+	for _, want := range []string{
+		"make(map[string]struct{}, len(body.Tags))",
+		"for _, v := range body.Tags",
+		`Kind: "uniqueItems"`, `Field: "body.Tags"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q, got:\n%s", want, code)
+		}
+	}
+}
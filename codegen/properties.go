@@ -0,0 +1,36 @@
+package codegen
+
+import "fmt"
+
+// MinPropertiesCode returns the Go statement checking that the map held by
+// varName has at least min keys, as attached via dsl.MinProperties.
+func MinPropertiesCode(varName string, min int) string {
+	return fmt.Sprintf(
+		`if len(%s) < %d {
+	err = &goa.ValidationError{Kind: "minProperties", Field: %q, Params: map[string]interface{}{"min": %d}}
+}`, varName, min, varName, min)
+}
+
+// MaxPropertiesCode returns the Go statement checking that the map held by
+// varName has at most max keys, as attached via dsl.MaxProperties.
+func MaxPropertiesCode(varName string, max int) string {
+	return fmt.Sprintf(
+		`if len(%s) > %d {
+	err = &goa.ValidationError{Kind: "maxProperties", Field: %q, Params: map[string]interface{}{"max": %d}}
+}`, varName, max, varName, max)
+}
+
+// PropertyNamesCode returns the Go statement checking that every key of the
+// map held by varName matches pattern, as attached via dsl.PropertyNames.
+func PropertyNamesCode(varName, pattern string) string {
+	return fmt.Sprintf(
+		`{
+	re := regexp.MustCompile(%q)
+	for k := range %s {
+		if !re.MatchString(k) {
+			err = &goa.ValidationError{Kind: "propertyNames", Field: %q, Params: map[string]interface{}{"pattern": %q, "actual": k}}
+			break
+		}
+	}
+}`, pattern, varName, varName, pattern)
+}
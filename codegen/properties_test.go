@@ -0,0 +1,30 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMinPropertiesCode(t *testing.T) {
+	code := MinPropertiesCode("body", 2)
+	if !strings.Contains(code, "len(body) < 2") {
+		t.Errorf("expected a minimum length check, got:\n%s", code)
+	}
+}
+
+func TestMaxPropertiesCode(t *testing.T) {
+	code := MaxPropertiesCode("body", 5)
+	if !strings.Contains(code, "len(body) > 5") {
+		t.Errorf("expected a maximum length check, got:\n%s", code)
+	}
+}
+
+func TestPropertyNamesCode(t *testing.T) {
+	code := PropertyNamesCode("body", "^[a-z]+$")
+	if !strings.Contains(code, `regexp.MustCompile("^[a-z]+$")`) {
+		t.Errorf("expected the pattern to be compiled, got:\n%s", code)
+	}
+	if !strings.Contains(code, "for k := range body") {
+		t.Errorf("expected a loop over the map's keys, got:\n%s", code)
+	}
+}
@@ -0,0 +1,64 @@
+package dsl
+
+import (
+	"goa.design/goa/eval"
+	"goa.design/goa/expr"
+)
+
+// OneOf adds a "oneOf" schema composition validation to the attribute: the
+// value must validate against exactly one of the given types.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor88.
+func OneOf(types ...expr.DataType) {
+	composition(expr.OneOfComposition, types)
+}
+
+// AnyOf adds an "anyOf" schema composition validation to the attribute: the
+// value must validate against at least one of the given types.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor90.
+func AnyOf(types ...expr.DataType) {
+	composition(expr.AnyOfComposition, types)
+}
+
+// AllOf adds an "allOf" schema composition validation to the attribute: the
+// value must validate against all of the given types.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor86.
+func AllOf(types ...expr.DataType) {
+	composition(expr.AllOfComposition, types)
+}
+
+// Not adds a "not" schema composition validation to the attribute: the value
+// must not validate against the given type.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor91.
+func Not(t expr.DataType) {
+	composition(expr.NotComposition, []expr.DataType{t})
+}
+
+// composition is the implementation shared by OneOf, AnyOf, AllOf and Not. It
+// validates that each given type is either a user type or an object defined
+// inline and stores the resulting expr.CompositionExpr on the current
+// attribute's validation.
+func composition(kind expr.CompositionKind, types []expr.DataType) {
+	a, ok := eval.Current().(*expr.AttributeExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	for i, t := range types {
+		if t == nil {
+			eval.ReportError("type at index %d is nil", i)
+			return
+		}
+		if _, ok := t.(expr.UserType); !ok && t.Kind() != expr.ObjectKind {
+			eval.ReportError("type at index %d must be a user type or an object defined inline, got %s", i, t.Name())
+			return
+		}
+	}
+	if a.Validation == nil {
+		a.Validation = &expr.ValidationExpr{}
+	}
+	if a.Validation.Composition != nil {
+		eval.ReportError("composition validation already defined, OneOf, AnyOf, AllOf and Not are mutually exclusive on a given attribute")
+		return
+	}
+	a.Validation.Composition = &expr.CompositionExpr{Kind: kind, Types: types}
+}
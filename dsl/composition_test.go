@@ -0,0 +1,45 @@
+package dsl
+
+import (
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+func TestComposition(t *testing.T) {
+	t.Run("OneOf with inline objects succeeds", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() { OneOf(expr.Object{}, expr.Object{}) }, att)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if att.Validation == nil || att.Validation.Composition == nil {
+			t.Fatal("expected a composition to be set")
+		}
+		if att.Validation.Composition.Kind != expr.OneOfComposition {
+			t.Fatalf("expected OneOfComposition, got %v", att.Validation.Composition.Kind)
+		}
+	})
+
+	t.Run("a scalar type is rejected", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() { AnyOf(expr.String) }, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+	})
+
+	t.Run("a second composition on the same attribute is rejected", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() {
+			OneOf(expr.Object{})
+			Not(expr.Object{})
+		}, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+		if att.Validation.Composition.Kind != expr.OneOfComposition {
+			t.Fatal("expected the first composition to be kept")
+		}
+	})
+}
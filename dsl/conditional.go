@@ -0,0 +1,107 @@
+package dsl
+
+import (
+	"goa.design/goa/eval"
+	"goa.design/goa/expr"
+)
+
+// If starts a conditional validation block on the current attribute: the DSL
+// given as argument describes a predicate evaluated against the attribute's
+// own fields using the ordinary validators (Enum, Pattern, Required,
+// Minimum, ...). If must be immediately followed, in the enclosing
+// attribute's DSL, by a Then and optionally an Else that define the
+// validators applied when the predicate holds or fails. The predicate, Then
+// and Else blocks each run against their own independent copy of the
+// enclosing attribute's type (see AttributeExpr.Dup), so a validator applied
+// inside one of them never leaks onto the live sibling attributes or onto
+// the other blocks.
+// See https://json-schema.org/draft-07/json-schema-validation.html#rfc.section.5.5.
+//
+//        Attribute("payload", func() {
+//                Attribute("kind", String)
+//                Attribute("target", String)
+//
+//                If(func() {
+//                        Attribute("kind", String)
+//                        Enum("email")
+//                })
+//                Then(func() {
+//                        Attribute("target", String)
+//                        Format(FormatEmail)
+//                })
+//                Else(func() {
+//                        Attribute("target", String)
+//                        Format(FormatURI)
+//                })
+//        })
+func If(fn func()) {
+	a, ok := eval.Current().(*expr.AttributeExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	// The predicate gets its own deep copy of the enclosing attribute's
+	// type (see AttributeExpr.Dup) so nested DSL resolves against the
+	// real sibling fields, yet any validator it runs (the predicate is
+	// just read, never applied) can't leak onto the live attribute or
+	// onto the independent copies Then and Else get below.
+	predicate := &expr.AttributeExpr{Type: dupType(a.Type)}
+	eval.Execute(fn, predicate)
+	if a.Validation == nil {
+		a.Validation = &expr.ValidationExpr{}
+	}
+	a.Validation.Conditionals = append(a.Validation.Conditionals, &expr.ConditionalExpr{Predicate: predicate})
+}
+
+// Then defines the validators applied when the predicate of the preceding If
+// holds. See If for a usage example.
+func Then(fn func()) {
+	a, cond := currentConditional("Then")
+	if cond == nil {
+		return
+	}
+	if cond.Then != nil {
+		eval.ReportError("Then already defined for this If")
+		return
+	}
+	then := &expr.AttributeExpr{Type: dupType(a.Type)}
+	eval.Execute(fn, then)
+	cond.Then = then
+}
+
+// Else defines the validators applied when the predicate of the preceding If
+// fails. See If for a usage example.
+func Else(fn func()) {
+	a, cond := currentConditional("Else")
+	if cond == nil {
+		return
+	}
+	if cond.Else != nil {
+		eval.ReportError("Else already defined for this If")
+		return
+	}
+	els := &expr.AttributeExpr{Type: dupType(a.Type)}
+	eval.Execute(fn, els)
+	cond.Else = els
+}
+
+// dupType returns a deep copy of t safe to mutate independently of the
+// original, or nil if t is nil.
+func dupType(t expr.DataType) expr.DataType {
+	if t == nil {
+		return nil
+	}
+	return t.Dup()
+}
+
+// currentConditional returns the current attribute together with the most
+// recently started conditional on it, reporting an error and returning a nil
+// conditional if no If was called on the attribute before Then or Else.
+func currentConditional(caller string) (*expr.AttributeExpr, *expr.ConditionalExpr) {
+	a, ok := eval.Current().(*expr.AttributeExpr)
+	if !ok || a.Validation == nil || len(a.Validation.Conditionals) == 0 {
+		eval.ReportError("%s must immediately follow an If", caller)
+		return nil, nil
+	}
+	return a, a.Validation.Conditionals[len(a.Validation.Conditionals)-1]
+}
@@ -0,0 +1,109 @@
+package dsl
+
+import (
+	"testing"
+
+	"goa.design/goa/eval"
+	"goa.design/goa/expr"
+)
+
+func TestIfThenElse(t *testing.T) {
+	t.Run("Then without If reports an error", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() { Then(func() {}) }, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+	})
+
+	t.Run("Else without If reports an error", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() { Else(func() {}) }, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+	})
+
+	t.Run("If followed by Then and Else succeeds", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() {
+			If(func() {})
+			Then(func() {})
+			Else(func() {})
+		}, att)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if len(att.Validation.Conditionals) != 1 {
+			t.Fatalf("expected 1 conditional, got %d", len(att.Validation.Conditionals))
+		}
+		cond := att.Validation.Conditionals[0]
+		if cond.Then == nil || cond.Else == nil {
+			t.Fatal("expected both Then and Else to be set")
+		}
+	})
+
+	t.Run("a second Then for the same If reports an error", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() {
+			If(func() {})
+			Then(func() {})
+			Then(func() {})
+		}, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+	})
+
+	t.Run("a second Else for the same If reports an error", func(t *testing.T) {
+		att := &expr.AttributeExpr{Type: expr.Object{}}
+		errs := runDSL(func() {
+			If(func() {})
+			Else(func() {})
+			Else(func() {})
+		}, att)
+		if len(errs) == 0 {
+			t.Fatal("expected an error, got none")
+		}
+	})
+
+	t.Run("Then and Else do not leak validators onto the live sibling attribute", func(t *testing.T) {
+		target := &expr.AttributeExpr{Type: expr.String}
+		obj := expr.Object{{Name: "kind", Attribute: &expr.AttributeExpr{Type: expr.String}}, {Name: "target", Attribute: target}}
+		att := &expr.AttributeExpr{Type: obj}
+		errs := runDSL(func() {
+			If(func() {})
+			Then(func() {
+				a, ok := eval.Current().(*expr.AttributeExpr)
+				if !ok {
+					t.Fatal("Then block did not run against an attribute")
+				}
+				sibling := a.Type.(expr.Object).Attribute("target")
+				sibling.Validation = &expr.ValidationExpr{Format: expr.FormatEmail}
+			})
+			Else(func() {
+				a, ok := eval.Current().(*expr.AttributeExpr)
+				if !ok {
+					t.Fatal("Else block did not run against an attribute")
+				}
+				sibling := a.Type.(expr.Object).Attribute("target")
+				sibling.Validation = &expr.ValidationExpr{Format: expr.FormatURI}
+			})
+		}, att)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if target.Validation != nil {
+			t.Fatalf("expected the live sibling attribute to be unaffected by Then/Else, got %+v", target.Validation)
+		}
+		cond := att.Validation.Conditionals[0]
+		thenTarget := cond.Then.Type.(expr.Object).Attribute("target")
+		elseTarget := cond.Else.Type.(expr.Object).Attribute("target")
+		if thenTarget.Validation == nil || thenTarget.Validation.Format != expr.FormatEmail {
+			t.Fatalf("expected Then's copy to keep its own Format, got %+v", thenTarget.Validation)
+		}
+		if elseTarget.Validation == nil || elseTarget.Validation.Format != expr.FormatURI {
+			t.Fatalf("expected Else's copy to keep its own Format, got %+v", elseTarget.Validation)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package dsl
+
+import "goa.design/goa/expr"
+
+// FormatOption configures a format registered via RegisterFormat.
+type FormatOption func(*expr.FormatConfig)
+
+// WithGoSymbol sets the fully qualified name of the Go function or method
+// (e.g. "mypkg.ValidateIBAN") that codegen calls to validate the format at
+// runtime instead of emitting the built-in regexp/time based check.
+func WithGoSymbol(symbol string) FormatOption {
+	return func(cfg *expr.FormatConfig) {
+		cfg.GoSymbol = symbol
+	}
+}
+
+// RegisterFormat extends the set of formats accepted by Format with a
+// custom, user-defined one. It may be called from a package init function or
+// from within the top-level API DSL, for example:
+//
+//        func init() {
+//                dsl.RegisterFormat("iban", validateIBAN, dsl.WithGoSymbol("formats.ValidateIBAN"))
+//        }
+//
+//        var _ = API("my api", func() {
+//                ...
+//        })
+//
+// checker is used by the goa tooling (e.g. the DSL evaluator and example
+// generation) to validate format values at design time. WithGoSymbol
+// identifies the Go symbol generated code should call instead so that the
+// same custom logic runs at runtime. Without WithGoSymbol codegen emits a
+// warning comment in place of the check, so the generated code does not
+// validate the format at runtime.
+func RegisterFormat(name string, checker func(string) bool, opts ...FormatOption) {
+	cfg := &expr.FormatConfig{Checker: checker}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	expr.RegisterFormat(expr.ValidationFormat(name), cfg)
+}
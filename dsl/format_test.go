@@ -0,0 +1,39 @@
+package dsl
+
+import (
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+func TestRegisterFormat(t *testing.T) {
+	RegisterFormat("dsl-format-test-iban", func(string) bool { return true }, WithGoSymbol("formats.ValidateIBAN"))
+
+	att := &expr.AttributeExpr{}
+	if !att.IsSupportedValidationFormat("dsl-format-test-iban") {
+		t.Fatal("expected the registered format to be recognized as supported")
+	}
+	cfg := expr.LookupFormat("dsl-format-test-iban")
+	if cfg == nil || cfg.GoSymbol != "formats.ValidateIBAN" {
+		t.Fatalf("expected GoSymbol to be set via WithGoSymbol, got %+v", cfg)
+	}
+}
+
+func TestRegisterFormatNilChecker(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected RegisterFormat to panic on a nil checker")
+		}
+	}()
+	RegisterFormat("dsl-format-test-nil-checker", nil)
+}
+
+func TestRegisterFormatDuplicate(t *testing.T) {
+	RegisterFormat("dsl-format-test-duplicate", func(string) bool { return true })
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected RegisterFormat to panic when registering the same name twice")
+		}
+	}()
+	RegisterFormat("dsl-format-test-duplicate", func(string) bool { return true })
+}
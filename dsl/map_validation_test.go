@@ -0,0 +1,57 @@
+package dsl
+
+import (
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+func TestMinMaxProperties(t *testing.T) {
+	cases := []struct {
+		name    string
+		typ     expr.DataType
+		wantErr bool
+	}{
+		{"map", expr.MapOf(expr.String, expr.String), false},
+		{"object", expr.Object{}, false},
+		{"string", expr.String, true},
+	}
+	for _, c := range cases {
+		t.Run("MinProperties/"+c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { MinProperties(1) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+		t.Run("MaxProperties/"+c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { MaxProperties(10) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestPropertyNames(t *testing.T) {
+	cases := []struct {
+		name    string
+		typ     expr.DataType
+		pattern string
+		wantErr bool
+	}{
+		{"valid pattern on map", expr.MapOf(expr.String, expr.String), "^[a-z]+$", false},
+		{"invalid pattern", expr.MapOf(expr.String, expr.String), "(unterminated", true},
+		{"incompatible type", expr.String, "^[a-z]+$", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { PropertyNames(c.pattern) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+	}
+}
@@ -118,6 +118,19 @@ import (
 //
 //        Meta("swagger:extension:x-api", `{"foo":"bar"}`)
 //
+// `validation:message:xxx` overrides, for the attribute it is set on, the
+// message generated when the validation named xxx fails (xxx is one of the
+// validation kinds known to expr.DefaultMessages, e.g. "minimum",
+// "maxLength", "pattern", "required", "enum" or "format"). The message may
+// reference the interpolation tokens {min}, {max}, {actual} and {field},
+// substituted at runtime from the corresponding goa.ValidationError's Field
+// and Params. See AttributeExpr.ValidationMessage and goa.ValidationError.
+//
+//        Attribute("age", Int, func() {
+//                Minimum(18)
+//                Meta("validation:message:minimum", "you must be at least {min} years old")
+//        })
+//
 // The special key names listed above may be used as follows:
 //
 //        var Account = Type("Account", func() {
@@ -191,6 +191,115 @@ func Maximum(val interface{}) {
 	}
 }
 
+// MultipleOf adds a "multipleOf" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor14.
+func MultipleOf(val interface{}) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil &&
+			a.Type.Kind() != expr.IntKind && a.Type.Kind() != expr.UIntKind &&
+			a.Type.Kind() != expr.Int32Kind && a.Type.Kind() != expr.UInt32Kind &&
+			a.Type.Kind() != expr.Int64Kind && a.Type.Kind() != expr.UInt64Kind &&
+			a.Type.Kind() != expr.Float32Kind && a.Type.Kind() != expr.Float64Kind {
+
+			incompatibleAttributeType("multipleOf", a.Type.Name(), "an integer or a number")
+		} else {
+			var f float64
+			switch v := val.(type) {
+			case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+				f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
+			case string:
+				var err error
+				f, err = strconv.ParseFloat(v, 64)
+				if err != nil {
+					eval.ReportError("invalid number value %#v", v)
+					return
+				}
+			default:
+				eval.ReportError("invalid number value %#v", v)
+				return
+			}
+			if f <= 0 {
+				eval.ReportError("multipleOf value must be positive, got %#v", val)
+				return
+			}
+			if a.Validation == nil {
+				a.Validation = &expr.ValidationExpr{}
+			}
+			a.Validation.MultipleOf = &f
+		}
+	}
+}
+
+// ExclusiveMinimum adds an "exclusiveMinimum" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor21.
+func ExclusiveMinimum(val interface{}) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil &&
+			a.Type.Kind() != expr.IntKind && a.Type.Kind() != expr.UIntKind &&
+			a.Type.Kind() != expr.Int32Kind && a.Type.Kind() != expr.UInt32Kind &&
+			a.Type.Kind() != expr.Int64Kind && a.Type.Kind() != expr.UInt64Kind &&
+			a.Type.Kind() != expr.Float32Kind && a.Type.Kind() != expr.Float64Kind {
+
+			incompatibleAttributeType("exclusiveMinimum", a.Type.Name(), "an integer or a number")
+		} else {
+			var f float64
+			switch v := val.(type) {
+			case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+				f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
+			case string:
+				var err error
+				f, err = strconv.ParseFloat(v, 64)
+				if err != nil {
+					eval.ReportError("invalid number value %#v", v)
+					return
+				}
+			default:
+				eval.ReportError("invalid number value %#v", v)
+				return
+			}
+			if a.Validation == nil {
+				a.Validation = &expr.ValidationExpr{}
+			}
+			a.Validation.ExclusiveMinimum = &f
+		}
+	}
+}
+
+// ExclusiveMaximum adds an "exclusiveMaximum" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor17.
+func ExclusiveMaximum(val interface{}) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil &&
+			a.Type.Kind() != expr.IntKind && a.Type.Kind() != expr.UIntKind &&
+			a.Type.Kind() != expr.Int32Kind && a.Type.Kind() != expr.UInt32Kind &&
+			a.Type.Kind() != expr.Int64Kind && a.Type.Kind() != expr.UInt64Kind &&
+			a.Type.Kind() != expr.Float32Kind && a.Type.Kind() != expr.Float64Kind {
+
+			incompatibleAttributeType("exclusiveMaximum", a.Type.Name(), "an integer or a number")
+		} else {
+			var f float64
+			switch v := val.(type) {
+			case float32, float64, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+				f = reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0.0))).Float()
+			case string:
+				var err error
+				f, err = strconv.ParseFloat(v, 64)
+				if err != nil {
+					eval.ReportError("invalid number value %#v", v)
+					return
+				}
+			default:
+				eval.ReportError("invalid number value %#v", v)
+				return
+			}
+			if a.Validation == nil {
+				a.Validation = &expr.ValidationExpr{}
+			}
+			a.Validation.ExclusiveMaximum = &f
+		}
+	}
+}
+
 // MinLength adds a "minItems" validation to the attribute.
 // See http://json-schema.org/latest/json-schema-validation.html#anchor45.
 func MinLength(val int) {
@@ -235,6 +344,75 @@ func MaxLength(val int) {
 	}
 }
 
+// UniqueItems adds a "uniqueItems" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor49.
+func UniqueItems() {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil {
+			kind := a.Type.Kind()
+			if kind != expr.ArrayKind && kind != expr.MapKind {
+				incompatibleAttributeType("uniqueItems", a.Type.Name(), "an array or a map")
+				return
+			}
+		}
+		if a.Validation == nil {
+			a.Validation = &expr.ValidationExpr{}
+		}
+		a.Validation.UniqueItems = true
+	}
+}
+
+// MinProperties adds a "minProperties" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor54.
+func MinProperties(n int) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil && a.Type.Kind() != expr.MapKind && a.Type.Kind() != expr.ObjectKind {
+			incompatibleAttributeType("minProperties", a.Type.Name(), "a map or an object")
+			return
+		}
+		if a.Validation == nil {
+			a.Validation = &expr.ValidationExpr{}
+		}
+		a.Validation.MinProperties = &n
+	}
+}
+
+// MaxProperties adds a "maxProperties" validation to the attribute.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor57.
+func MaxProperties(n int) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil && a.Type.Kind() != expr.MapKind && a.Type.Kind() != expr.ObjectKind {
+			incompatibleAttributeType("maxProperties", a.Type.Name(), "a map or an object")
+			return
+		}
+		if a.Validation == nil {
+			a.Validation = &expr.ValidationExpr{}
+		}
+		a.Validation.MaxProperties = &n
+	}
+}
+
+// PropertyNames adds a "propertyNames" validation to the attribute: every key
+// of a map-shaped attribute must match the given regular expression.
+// See http://json-schema.org/latest/json-schema-validation.html#anchor64.
+func PropertyNames(pattern string) {
+	if a, ok := eval.Current().(*expr.AttributeExpr); ok {
+		if a.Type != nil && a.Type.Kind() != expr.MapKind && a.Type.Kind() != expr.ObjectKind {
+			incompatibleAttributeType("propertyNames", a.Type.Name(), "a map or an object")
+			return
+		}
+		_, err := regexp.Compile(pattern)
+		if err != nil {
+			eval.ReportError("invalid pattern %#v, %s", pattern, err)
+			return
+		}
+		if a.Validation == nil {
+			a.Validation = &expr.ValidationExpr{}
+		}
+		a.Validation.PropertyNamesPattern = pattern
+	}
+}
+
 // Required adds a "required" validation to the attribute.
 // See http://json-schema.org/latest/json-schema-validation.html#anchor61.
 func Required(names ...string) {
@@ -0,0 +1,95 @@
+package dsl
+
+import (
+	"testing"
+
+	"goa.design/goa/eval"
+	"goa.design/goa/expr"
+)
+
+// runDSL executes fn with att as the current expression and returns the
+// errors reported while doing so.
+func runDSL(fn func(), att *expr.AttributeExpr) []error {
+	eval.Context = &eval.DSLContext{}
+	eval.Execute(fn, att)
+	return eval.Context.Errors
+}
+
+func TestMultipleOf(t *testing.T) {
+	cases := []struct {
+		name    string
+		typ     expr.DataType
+		val     interface{}
+		wantErr bool
+	}{
+		{"valid int", expr.Int, 2, false},
+		{"valid float", expr.Float64, 0.5, false},
+		{"zero is not positive", expr.Int, 0, true},
+		{"negative is not positive", expr.Int, -3, true},
+		{"incompatible type", expr.String, 2, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { MultipleOf(c.val) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+			if !c.wantErr && (att.Validation == nil || att.Validation.MultipleOf == nil) {
+				t.Fatalf("expected MultipleOf to be set on validation")
+			}
+		})
+	}
+}
+
+func TestExclusiveMinimumMaximum(t *testing.T) {
+	cases := []struct {
+		name    string
+		typ     expr.DataType
+		wantErr bool
+	}{
+		{"valid numeric type", expr.Int, false},
+		{"incompatible type", expr.String, true},
+	}
+	for _, c := range cases {
+		t.Run("ExclusiveMinimum/"+c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { ExclusiveMinimum(1) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+		t.Run("ExclusiveMaximum/"+c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { ExclusiveMaximum(10) }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestUniqueItems(t *testing.T) {
+	cases := []struct {
+		name    string
+		typ     expr.DataType
+		wantErr bool
+	}{
+		{"array", expr.ArrayOf(expr.String), false},
+		{"map", expr.MapOf(expr.String, expr.String), false},
+		{"string", expr.String, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			att := &expr.AttributeExpr{Type: c.typ}
+			errs := runDSL(func() { UniqueItems() }, att)
+			if (len(errs) > 0) != c.wantErr {
+				t.Fatalf("got errors %v, wantErr %v", errs, c.wantErr)
+			}
+			if !c.wantErr && (att.Validation == nil || !att.Validation.UniqueItems) {
+				t.Fatalf("expected UniqueItems to be set on validation")
+			}
+		})
+	}
+}
+
@@ -0,0 +1,52 @@
+// Package eval implements the evaluation engine for the goa design DSLs. It
+// keeps track of the expression currently being populated so that DSL
+// functions (in package dsl) can attach state to it and accumulates the
+// errors reported while a DSL runs.
+package eval
+
+import "fmt"
+
+// DSLContext holds the state accumulated while evaluating a DSL.
+type DSLContext struct {
+	// Errors lists the errors reported via ReportError while the DSL ran.
+	Errors []error
+}
+
+// Context is the context used by the DSL currently being evaluated. Callers
+// (typically the top-level design eval or tests) reset it to a fresh
+// *DSLContext before each run.
+var Context = &DSLContext{}
+
+// stack holds the expressions currently being evaluated, the top of the
+// stack being the one Current returns.
+var stack []interface{}
+
+// Execute runs fn with def pushed as the current expression so that Current
+// returns def for the duration of the call. It is used to run the DSL
+// function given to constructs such as Type, Attribute or, for example, the
+// If/Then/Else conditional blocks.
+func Execute(fn func(), def interface{}) {
+	stack = append(stack, def)
+	defer func() { stack = stack[:len(stack)-1] }()
+	fn()
+}
+
+// Current returns the expression currently being evaluated or nil if there
+// is none.
+func Current() interface{} {
+	if len(stack) == 0 {
+		return nil
+	}
+	return stack[len(stack)-1]
+}
+
+// ReportError records an error on the current DSL context.
+func ReportError(format string, vals ...interface{}) {
+	Context.Errors = append(Context.Errors, fmt.Errorf(format, vals...))
+}
+
+// IncompatibleDSL reports that a DSL function was invoked in a context where
+// it does not apply given the current expression.
+func IncompatibleDSL() {
+	ReportError("invalid use of DSL function: incompatible with current expression %#v", Current())
+}
@@ -0,0 +1,65 @@
+package expr
+
+// ArrayExpr represents an array type, i.e. a variable length list of values
+// of the same element type.
+type ArrayExpr struct {
+	ElemType DataType
+}
+
+// ArrayOf creates an array type with the given element type.
+func ArrayOf(elem DataType) *ArrayExpr { return &ArrayExpr{ElemType: elem} }
+
+// Kind returns ArrayKind.
+func (a *ArrayExpr) Kind() Kind { return ArrayKind }
+
+// Name returns "array".
+func (a *ArrayExpr) Name() string { return "array" }
+
+// IsCompatible returns true if val is an ArrayVal.
+func (a *ArrayExpr) IsCompatible(val interface{}) bool {
+	_, ok := val.(ArrayVal)
+	return ok
+}
+
+// Dup returns the array type itself: arrays don't carry mutable
+// per-attribute validation state of their own (MinLength/MaxLength/
+// UniqueItems live on the attribute referencing the array, not the type).
+func (a *ArrayExpr) Dup() DataType { return a }
+
+// MapExpr represents a map type, i.e. an association of keys to values.
+type MapExpr struct {
+	KeyType  DataType
+	ElemType DataType
+}
+
+// MapOf creates a map type with the given key and element types.
+func MapOf(key, elem DataType) *MapExpr { return &MapExpr{KeyType: key, ElemType: elem} }
+
+// Kind returns MapKind.
+func (m *MapExpr) Kind() Kind { return MapKind }
+
+// Name returns "map".
+func (m *MapExpr) Name() string { return "map" }
+
+// IsCompatible returns true if val is a MapVal.
+func (m *MapExpr) IsCompatible(val interface{}) bool {
+	_, ok := val.(MapVal)
+	return ok
+}
+
+// Dup returns the map type itself, see ArrayExpr.Dup.
+func (m *MapExpr) Dup() DataType { return m }
+
+// ArrayVal is the DSL-level representation of an array literal, e.g. as
+// given to Enum.
+type ArrayVal []interface{}
+
+// ToSlice converts the value to a plain []interface{}.
+func (a ArrayVal) ToSlice() []interface{} { return []interface{}(a) }
+
+// MapVal is the DSL-level representation of a map literal, e.g. as given to
+// Enum.
+type MapVal map[interface{}]interface{}
+
+// ToMap converts the value to a plain map[interface{}]interface{}.
+func (m MapVal) ToMap() map[interface{}]interface{} { return map[interface{}]interface{}(m) }
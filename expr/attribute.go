@@ -0,0 +1,49 @@
+package expr
+
+// AttributeExpr describes a field of an object, a parameter, the payload or
+// result of a method, etc. It holds the field's type together with any
+// validation attached to it via the DSL.
+type AttributeExpr struct {
+	Type       DataType
+	Validation *ValidationExpr
+	Meta       MetaExpr
+}
+
+// MetaExpr is the data structure backing the Meta DSL: a set of key/value
+// pairs where each value is itself a list of strings.
+type MetaExpr map[string][]string
+
+// CompositeExpr is implemented by expressions, such as user types, that wrap
+// an underlying attribute.
+type CompositeExpr interface {
+	Attribute() *AttributeExpr
+}
+
+// Dup returns a deep copy of the attribute: its type (if any) and its
+// validation and meta (if any) are copied so that the result can be mutated
+// without affecting the original. This is what keeps the branches of an
+// If/Then/Else conditional, for example, from clobbering each other's
+// validations or the enclosing attribute's.
+func (a *AttributeExpr) Dup() *AttributeExpr {
+	if a == nil {
+		return nil
+	}
+	dup := &AttributeExpr{}
+	if a.Type != nil {
+		dup.Type = a.Type.Dup()
+	}
+	if a.Validation != nil {
+		v := *a.Validation
+		dup.Validation = &v
+	}
+	if a.Meta != nil {
+		m := make(MetaExpr, len(a.Meta))
+		for k, v := range a.Meta {
+			vv := make([]string, len(v))
+			copy(vv, v)
+			m[k] = vv
+		}
+		dup.Meta = m
+	}
+	return dup
+}
@@ -0,0 +1,22 @@
+package expr
+
+// CompositionKind identifies the kind of schema composition (OneOf, AnyOf,
+// AllOf or Not) attached to an attribute.
+type CompositionKind int
+
+const (
+	// OneOfComposition requires that exactly one of Types validates.
+	OneOfComposition CompositionKind = iota + 1
+	// AnyOfComposition requires that at least one of Types validates.
+	AnyOfComposition
+	// AllOfComposition requires that all of Types validate.
+	AllOfComposition
+	// NotComposition requires that Types[0] does not validate.
+	NotComposition
+)
+
+// CompositionExpr is the expression built by OneOf, AnyOf, AllOf and Not.
+type CompositionExpr struct {
+	Kind  CompositionKind
+	Types []DataType
+}
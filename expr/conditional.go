@@ -0,0 +1,11 @@
+package expr
+
+// ConditionalExpr is the expression built by If, Then and Else: Predicate
+// describes the condition (interpreted as ordinary validators applied to the
+// enclosing attribute's sibling fields), Then and Else the validators
+// applied when the predicate holds or fails respectively.
+type ConditionalExpr struct {
+	Predicate *AttributeExpr
+	Then      *AttributeExpr
+	Else      *AttributeExpr
+}
@@ -0,0 +1,28 @@
+package expr
+
+// ResultTypeExpr describes the shape of a method result. It wraps an
+// attribute, typically an Object.
+type ResultTypeExpr struct {
+	*AttributeExpr
+	Identifier string
+	Meta       MetaExpr
+}
+
+// MethodExpr describes a single service method.
+type MethodExpr struct {
+	Name string
+	Meta MetaExpr
+}
+
+// ServiceExpr describes an API service, i.e. a set of methods.
+type ServiceExpr struct {
+	Name string
+	Meta MetaExpr
+}
+
+// APIExpr is the root expression of a design, it describes the API as a
+// whole.
+type APIExpr struct {
+	Name string
+	Meta MetaExpr
+}
@@ -0,0 +1,92 @@
+package expr
+
+// ValidationFormat identifies one of the formats that can be given to the
+// Format DSL.
+type ValidationFormat string
+
+// Supported formats.
+const (
+	FormatDate     ValidationFormat = "date"
+	FormatDateTime ValidationFormat = "date-time"
+	FormatUUID     ValidationFormat = "uuid"
+	FormatEmail    ValidationFormat = "email"
+	FormatHostname ValidationFormat = "hostname"
+	FormatIPv4     ValidationFormat = "ipv4"
+	FormatIPv6     ValidationFormat = "ipv6"
+	FormatIP       ValidationFormat = "ip"
+	FormatURI      ValidationFormat = "uri"
+	FormatMAC      ValidationFormat = "mac"
+	FormatCIDR     ValidationFormat = "cidr"
+	FormatRegexp   ValidationFormat = "regexp"
+	FormatJSON     ValidationFormat = "json"
+	FormatRFC1123  ValidationFormat = "rfc1123"
+)
+
+// builtinFormats lists the formats supported out of the box.
+var builtinFormats = map[ValidationFormat]bool{
+	FormatDate:     true,
+	FormatDateTime: true,
+	FormatUUID:     true,
+	FormatEmail:    true,
+	FormatHostname: true,
+	FormatIPv4:     true,
+	FormatIPv6:     true,
+	FormatIP:       true,
+	FormatURI:      true,
+	FormatMAC:      true,
+	FormatCIDR:     true,
+	FormatRegexp:   true,
+	FormatJSON:     true,
+	FormatRFC1123:  true,
+}
+
+// IsSupportedValidationFormat returns true if f is one of the built-in
+// formats or was added via RegisterFormat.
+func (a *AttributeExpr) IsSupportedValidationFormat(f ValidationFormat) bool {
+	if builtinFormats[f] {
+		return true
+	}
+	_, ok := formatRegistry[f]
+	return ok
+}
+
+// FormatConfig configures a format registered via RegisterFormat.
+type FormatConfig struct {
+	// Checker validates a value against the format at design time (e.g.
+	// when generating examples).
+	Checker func(string) bool
+	// GoSymbol is the fully qualified name of the Go function or method
+	// codegen calls to validate the format at runtime (e.g.
+	// "formats.ValidateIBAN"). When empty codegen emits a warning comment
+	// in place of the check and the format is not validated at runtime.
+	GoSymbol string
+}
+
+// formatRegistry holds the formats registered via RegisterFormat, keyed by
+// name.
+var formatRegistry = map[ValidationFormat]*FormatConfig{}
+
+// RegisterFormat extends the set of formats accepted by Format and
+// IsSupportedValidationFormat with a custom one. It panics if name is
+// already registered (whether built-in or custom) or if checker is nil,
+// mirroring the fail-fast behavior of similar registries such as
+// database/sql.Register: these are programming errors caught at init time,
+// not design-time DSL mistakes reported via eval.ReportError.
+func RegisterFormat(name ValidationFormat, cfg *FormatConfig) {
+	if cfg == nil || cfg.Checker == nil {
+		panic("expr: RegisterFormat called with a nil checker for format " + string(name))
+	}
+	if builtinFormats[name] {
+		panic("expr: RegisterFormat: " + string(name) + " is already a built-in format")
+	}
+	if _, ok := formatRegistry[name]; ok {
+		panic("expr: RegisterFormat called twice for format " + string(name))
+	}
+	formatRegistry[name] = cfg
+}
+
+// LookupFormat returns the configuration registered for a custom format, or
+// nil if name isn't registered.
+func LookupFormat(name ValidationFormat) *FormatConfig {
+	return formatRegistry[name]
+}
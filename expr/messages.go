@@ -0,0 +1,44 @@
+package expr
+
+// DefaultMessages is the default English catalog of validation error message
+// templates, keyed by validation kind (the same names used in the generated
+// code and in the "validation:message:xxx" Meta key below). Templates may
+// reference the {field} token and any token named after a Params key (e.g.
+// {min}, {max}, {actual}, {pattern}, {format} or {multipleOf}), substituted
+// by the caller (see goa.ValidationError.Localize) from the error's Params
+// and Field.
+var DefaultMessages = map[string]string{
+	"minimum":          "{field} must be greater than or equal to {min}",
+	"maximum":          "{field} must be less than or equal to {max}",
+	"exclusiveMinimum": "{field} must be greater than {min}",
+	"exclusiveMaximum": "{field} must be less than {max}",
+	"minLength":        "{field} length must be greater than or equal to {min}",
+	"maxLength":        "{field} length must be less than or equal to {max}",
+	"minProperties":    "{field} must have at least {min} properties",
+	"maxProperties":    "{field} must have at most {max} properties",
+	"propertyNames":    "{field} has a property name that does not match the expected pattern",
+	"pattern":          "{field} must match the pattern {pattern}",
+	"required":         "{field} is required",
+	"enum":             "{field} must be one of {actual}",
+	"format":           "{field} must be a valid {format}",
+	"multipleOf":       "{field} must be a multiple of {multipleOf}",
+	"uniqueItems":      "{field} must not contain duplicate items",
+	"oneOf":            "{field} must validate against exactly one of the schemas",
+	"anyOf":            "{field} must validate against at least one of the schemas",
+	"allOf":            "{field} must validate against all of the schemas",
+	"not":              "{field} must not validate against the excluded schema",
+}
+
+// ValidationMessage returns the message template for the given validation
+// kind, honoring a per-attribute override set via
+// Meta("validation:message:"+kind, ...) and falling back to DefaultMessages
+// otherwise. If kind is not overridden and has no default either the empty
+// string is returned.
+func (a *AttributeExpr) ValidationMessage(kind string) string {
+	if a != nil && a.Meta != nil {
+		if vals, ok := a.Meta["validation:message:"+kind]; ok && len(vals) > 0 {
+			return vals[0]
+		}
+	}
+	return DefaultMessages[kind]
+}
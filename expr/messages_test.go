@@ -0,0 +1,26 @@
+package expr
+
+import "testing"
+
+func TestAttributeExprValidationMessage(t *testing.T) {
+	t.Run("falls back to the default message", func(t *testing.T) {
+		att := &AttributeExpr{}
+		if got := att.ValidationMessage("minimum"); got != DefaultMessages["minimum"] {
+			t.Errorf("got %q, want the default message", got)
+		}
+	})
+
+	t.Run("honors a validation:message override", func(t *testing.T) {
+		att := &AttributeExpr{Meta: MetaExpr{"validation:message:minimum": {"must be at least {min}"}}}
+		if got := att.ValidationMessage("minimum"); got != "must be at least {min}" {
+			t.Errorf("got %q, want the overridden message", got)
+		}
+	})
+
+	t.Run("returns empty string for an unknown kind with no override", func(t *testing.T) {
+		att := &AttributeExpr{}
+		if got := att.ValidationMessage("not-a-kind"); got != "" {
+			t.Errorf("got %q, want empty string", got)
+		}
+	})
+}
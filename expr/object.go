@@ -0,0 +1,58 @@
+package expr
+
+// Object represents an object type as an ordered list of named attributes.
+type Object []*NamedAttributeExpr
+
+// NamedAttributeExpr pairs an attribute name with its definition inside an
+// Object.
+type NamedAttributeExpr struct {
+	Name      string
+	Attribute *AttributeExpr
+}
+
+// Kind returns ObjectKind.
+func (o Object) Kind() Kind { return ObjectKind }
+
+// Name returns "object".
+func (o Object) Name() string { return "object" }
+
+// IsCompatible returns true if val is a map keyed by string, the shape used
+// to represent object values in the DSL.
+func (o Object) IsCompatible(val interface{}) bool {
+	_, ok := val.(map[string]interface{})
+	return ok
+}
+
+// Attribute returns the named attribute or nil if the object has none with
+// that name.
+func (o Object) Attribute(name string) *AttributeExpr {
+	for _, na := range o {
+		if na.Name == name {
+			return na.Attribute
+		}
+	}
+	return nil
+}
+
+// Set adds the attribute to the object or replaces it if the object already
+// has an attribute with the same name.
+func (o *Object) Set(name string, att *AttributeExpr) {
+	for _, na := range *o {
+		if na.Name == name {
+			na.Attribute = att
+			return
+		}
+	}
+	*o = append(*o, &NamedAttributeExpr{Name: name, Attribute: att})
+}
+
+// Dup returns a deep copy of the object: every named attribute is itself
+// duplicated so that validations added to the copy (for example by one
+// branch of an If/Then/Else) never affect the original nor any other copy.
+func (o Object) Dup() DataType {
+	dup := make(Object, len(o))
+	for i, na := range o {
+		dup[i] = &NamedAttributeExpr{Name: na.Name, Attribute: na.Attribute.Dup()}
+	}
+	return dup
+}
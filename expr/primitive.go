@@ -0,0 +1,84 @@
+package expr
+
+// Primitive is the type for the built-in scalar goa types (Boolean, Int,
+// String, ...).
+type Primitive Kind
+
+// Kind returns the primitive's kind.
+func (p Primitive) Kind() Kind { return Kind(p) }
+
+// Name returns the primitive's name.
+func (p Primitive) Name() string {
+	switch Kind(p) {
+	case BooleanKind:
+		return "boolean"
+	case IntKind:
+		return "int"
+	case Int32Kind:
+		return "int32"
+	case Int64Kind:
+		return "int64"
+	case UIntKind:
+		return "uint"
+	case UInt32Kind:
+		return "uint32"
+	case UInt64Kind:
+		return "uint64"
+	case Float32Kind:
+		return "float32"
+	case Float64Kind:
+		return "float64"
+	case StringKind:
+		return "string"
+	case BytesKind:
+		return "bytes"
+	}
+	return "unknown"
+}
+
+// IsCompatible returns true if val can be coerced into a value of the
+// primitive's Go type.
+func (p Primitive) IsCompatible(val interface{}) bool {
+	switch Kind(p) {
+	case BooleanKind:
+		_, ok := val.(bool)
+		return ok
+	case IntKind, Int32Kind, Int64Kind, UIntKind, UInt32Kind, UInt64Kind:
+		switch val.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return true
+		}
+		return false
+	case Float32Kind, Float64Kind:
+		switch val.(type) {
+		case float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return true
+		}
+		return false
+	case StringKind:
+		_, ok := val.(string)
+		return ok
+	case BytesKind:
+		_, ok := val.([]byte)
+		return ok
+	}
+	return false
+}
+
+// Dup returns the primitive itself since primitives carry no mutable state.
+func (p Primitive) Dup() DataType { return p }
+
+// Predefined primitive types.
+var (
+	Boolean = Primitive(BooleanKind)
+	Int     = Primitive(IntKind)
+	Int32   = Primitive(Int32Kind)
+	Int64   = Primitive(Int64Kind)
+	UInt    = Primitive(UIntKind)
+	UInt32  = Primitive(UInt32Kind)
+	UInt64  = Primitive(UInt64Kind)
+	Float32 = Primitive(Float32Kind)
+	Float64 = Primitive(Float64Kind)
+	String  = Primitive(StringKind)
+	Bytes   = Primitive(BytesKind)
+)
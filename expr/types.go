@@ -0,0 +1,38 @@
+package expr
+
+// Kind identifies the kind of a goa data type.
+type Kind int
+
+const (
+	BooleanKind Kind = iota + 1
+	IntKind
+	Int32Kind
+	Int64Kind
+	UIntKind
+	UInt32Kind
+	UInt64Kind
+	Float32Kind
+	Float64Kind
+	StringKind
+	BytesKind
+	ArrayKind
+	MapKind
+	ObjectKind
+	UserTypeKind
+)
+
+// DataType is the interface implemented by all goa types: primitives,
+// arrays, maps, objects and user types.
+type DataType interface {
+	// Kind returns the type's kind.
+	Kind() Kind
+	// Name returns the type's name.
+	Name() string
+	// IsCompatible returns true if val can be coerced into a value of the
+	// type.
+	IsCompatible(val interface{}) bool
+	// Dup returns a copy of the type that is safe to mutate without
+	// affecting the original. Types that don't carry mutable per-attribute
+	// state (primitives, arrays, maps) may return themselves.
+	Dup() DataType
+}
@@ -0,0 +1,41 @@
+package expr
+
+// UserType is implemented by types defined by name in the design, e.g. via
+// Type or ResultType, as opposed to types defined inline.
+type UserType interface {
+	DataType
+	// Attribute returns the type's underlying attribute.
+	Attribute() *AttributeExpr
+}
+
+// UserTypeExpr is the default implementation of UserType.
+type UserTypeExpr struct {
+	// TypeName is the name of the type as given to Type or ResultType.
+	TypeName string
+	// AttributeExpr is the underlying attribute, its Type is typically an
+	// Object.
+	AttributeExpr *AttributeExpr
+}
+
+// Kind returns UserTypeKind.
+func (u *UserTypeExpr) Kind() Kind { return UserTypeKind }
+
+// Name returns the type name.
+func (u *UserTypeExpr) Name() string { return u.TypeName }
+
+// IsCompatible delegates to the underlying attribute's type.
+func (u *UserTypeExpr) IsCompatible(val interface{}) bool {
+	if u.AttributeExpr == nil || u.AttributeExpr.Type == nil {
+		return false
+	}
+	return u.AttributeExpr.Type.IsCompatible(val)
+}
+
+// Attribute returns the underlying attribute.
+func (u *UserTypeExpr) Attribute() *AttributeExpr { return u.AttributeExpr }
+
+// Dup returns a copy of the user type with a deep copy of its underlying
+// attribute, see AttributeExpr.Dup.
+func (u *UserTypeExpr) Dup() DataType {
+	return &UserTypeExpr{TypeName: u.TypeName, AttributeExpr: u.AttributeExpr.Dup()}
+}
@@ -0,0 +1,62 @@
+package expr
+
+// ValidationExpr holds the validations defined via the DSL (Enum, Format,
+// Pattern, Minimum, Maximum, ...) for a given attribute.
+type ValidationExpr struct {
+	// Values lists the allowed values, see Enum.
+	Values []interface{}
+	// Format is the validation format, see Format.
+	Format ValidationFormat
+	// Pattern is the validation regular expression, see Pattern.
+	Pattern string
+	// Minimum and Maximum are the bounds set via Minimum and Maximum.
+	Minimum *float64
+	Maximum *float64
+	// MinLength and MaxLength are the bounds set via MinLength and
+	// MaxLength.
+	MinLength *int
+	MaxLength *int
+	// MultipleOf is the divisor set via MultipleOf.
+	MultipleOf *float64
+	// ExclusiveMinimum and ExclusiveMaximum are the bounds set via
+	// ExclusiveMinimum and ExclusiveMaximum.
+	ExclusiveMinimum *float64
+	ExclusiveMaximum *float64
+	// UniqueItems is true if UniqueItems was called on the attribute.
+	UniqueItems bool
+	// MinProperties and MaxProperties are the bounds set via MinProperties
+	// and MaxProperties.
+	MinProperties *int
+	MaxProperties *int
+	// PropertyNamesPattern is the regular expression set via PropertyNames.
+	PropertyNamesPattern string
+	// Composition holds the OneOf/AnyOf/AllOf/Not schema composition set
+	// via the corresponding DSL functions.
+	Composition *CompositionExpr
+	// Conditionals holds the If/Then/Else conditional validations set via
+	// the corresponding DSL functions, in the order they were defined.
+	Conditionals []*ConditionalExpr
+	// requiredNames lists the names set via Required.
+	requiredNames []string
+}
+
+// AddRequired appends the given names to the set of required attributes,
+// skipping names already present.
+func (v *ValidationExpr) AddRequired(names ...string) {
+	for _, n := range names {
+		found := false
+		for _, r := range v.requiredNames {
+			if r == n {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.requiredNames = append(v.requiredNames, n)
+		}
+	}
+}
+
+// Required returns the names of the required attributes in the order they
+// were added.
+func (v *ValidationExpr) Required() []string { return v.requiredNames }
@@ -0,0 +1,100 @@
+// Package openapi builds OpenAPI/Swagger JSON Schema fragments from the
+// validations attached to attributes via the dsl package.
+package openapi
+
+import "goa.design/goa/expr"
+
+// Schema is a (subset of a) JSON Schema / OpenAPI schema object, populated
+// from an attribute's validations.
+type Schema struct {
+	MultipleOf       *float64      `json:"multipleOf,omitempty"`
+	Minimum          *float64      `json:"minimum,omitempty"`
+	Maximum          *float64      `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64      `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64      `json:"exclusiveMaximum,omitempty"`
+	MinLength        *int          `json:"minLength,omitempty"`
+	MaxLength        *int          `json:"maxLength,omitempty"`
+	MinProperties    *int          `json:"minProperties,omitempty"`
+	MaxProperties    *int          `json:"maxProperties,omitempty"`
+	PropertyNames    *Schema       `json:"propertyNames,omitempty"`
+	UniqueItems      bool          `json:"uniqueItems,omitempty"`
+	Pattern          string        `json:"pattern,omitempty"`
+	Format           string        `json:"format,omitempty"`
+	Enum             []interface{} `json:"enum,omitempty"`
+	OneOf            []*Schema     `json:"oneOf,omitempty"`
+	AnyOf            []*Schema     `json:"anyOf,omitempty"`
+	AllOf            []*Schema     `json:"allOf,omitempty"`
+	Not              *Schema       `json:"not,omitempty"`
+	// If, Then and Else are the OpenAPI 3.1 conditional keywords emitted
+	// for an attribute carrying If/Then/Else validations.
+	If   *Schema `json:"if,omitempty"`
+	Then *Schema `json:"then,omitempty"`
+	Else *Schema `json:"else,omitempty"`
+}
+
+// schemaForType builds the Schema fragment for a type referenced from a
+// composition (OneOf, AnyOf, AllOf, Not). User types carry their own
+// attribute and validations, which NewSchema recurses into; inline object
+// branches have no validations of their own beyond their member attributes
+// and so produce an empty fragment.
+func schemaForType(t expr.DataType) *Schema {
+	if ut, ok := t.(expr.UserType); ok {
+		return NewSchema(ut.Attribute())
+	}
+	return &Schema{}
+}
+
+// NewSchema builds the Schema fragment corresponding to att's validations.
+func NewSchema(att *expr.AttributeExpr) *Schema {
+	s := &Schema{}
+	if att == nil || att.Validation == nil {
+		return s
+	}
+	v := att.Validation
+	s.MultipleOf = v.MultipleOf
+	s.Minimum = v.Minimum
+	s.Maximum = v.Maximum
+	s.ExclusiveMinimum = v.ExclusiveMinimum
+	s.ExclusiveMaximum = v.ExclusiveMaximum
+	s.MinLength = v.MinLength
+	s.MaxLength = v.MaxLength
+	s.MinProperties = v.MinProperties
+	s.MaxProperties = v.MaxProperties
+	if v.PropertyNamesPattern != "" {
+		s.PropertyNames = &Schema{Pattern: v.PropertyNamesPattern}
+	}
+	s.UniqueItems = v.UniqueItems
+	s.Pattern = v.Pattern
+	s.Format = string(v.Format)
+	s.Enum = v.Values
+	if c := v.Composition; c != nil {
+		branches := make([]*Schema, len(c.Types))
+		for i, t := range c.Types {
+			branches[i] = schemaForType(t)
+		}
+		switch c.Kind {
+		case expr.OneOfComposition:
+			s.OneOf = branches
+		case expr.AnyOfComposition:
+			s.AnyOf = branches
+		case expr.AllOfComposition:
+			s.AllOf = branches
+		case expr.NotComposition:
+			s.Not = branches[0]
+		}
+	}
+	if len(v.Conditionals) > 0 {
+		// OpenAPI 3.1 only has room for a single if/then/else triple per
+		// schema object; as with the JSON Schema spec itself, only the
+		// first Conditionals entry is emitted here.
+		cond := v.Conditionals[0]
+		s.If = NewSchema(cond.Predicate)
+		if cond.Then != nil {
+			s.Then = NewSchema(cond.Then)
+		}
+		if cond.Else != nil {
+			s.Else = NewSchema(cond.Else)
+		}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package openapi
+
+import (
+	"testing"
+
+	"goa.design/goa/expr"
+)
+
+func TestNewSchemaNumeric(t *testing.T) {
+	multiple := 5.0
+	excMin := 0.0
+	att := &expr.AttributeExpr{
+		Type: expr.Int,
+		Validation: &expr.ValidationExpr{
+			MultipleOf:       &multiple,
+			ExclusiveMinimum: &excMin,
+			UniqueItems:      true,
+		},
+	}
+	s := NewSchema(att)
+	if s.MultipleOf == nil || *s.MultipleOf != multiple {
+		t.Errorf("expected MultipleOf %v, got %v", multiple, s.MultipleOf)
+	}
+	if s.ExclusiveMinimum == nil || *s.ExclusiveMinimum != excMin {
+		t.Errorf("expected ExclusiveMinimum %v, got %v", excMin, s.ExclusiveMinimum)
+	}
+	if !s.UniqueItems {
+		t.Errorf("expected UniqueItems true")
+	}
+}
+
+func TestNewSchemaProperties(t *testing.T) {
+	min, max := 1, 10
+	att := &expr.AttributeExpr{
+		Type: expr.Object{},
+		Validation: &expr.ValidationExpr{
+			MinProperties:        &min,
+			MaxProperties:        &max,
+			PropertyNamesPattern: "^[a-z]+$",
+		},
+	}
+	s := NewSchema(att)
+	if s.MinProperties == nil || *s.MinProperties != min {
+		t.Errorf("expected MinProperties %v, got %v", min, s.MinProperties)
+	}
+	if s.MaxProperties == nil || *s.MaxProperties != max {
+		t.Errorf("expected MaxProperties %v, got %v", max, s.MaxProperties)
+	}
+	if s.PropertyNames == nil || s.PropertyNames.Pattern != "^[a-z]+$" {
+		t.Errorf("expected PropertyNames pattern %q, got %+v", "^[a-z]+$", s.PropertyNames)
+	}
+}
+
+func TestNewSchemaComposition(t *testing.T) {
+	branch := &expr.UserTypeExpr{
+		TypeName: "CardPayment",
+		AttributeExpr: &expr.AttributeExpr{
+			Type:       expr.Object{},
+			Validation: &expr.ValidationExpr{Pattern: "^4"},
+		},
+	}
+	att := &expr.AttributeExpr{
+		Type: expr.Object{},
+		Validation: &expr.ValidationExpr{
+			Composition: &expr.CompositionExpr{Kind: expr.OneOfComposition, Types: []expr.DataType{branch}},
+		},
+	}
+	s := NewSchema(att)
+	if len(s.OneOf) != 1 {
+		t.Fatalf("expected 1 oneOf branch, got %d", len(s.OneOf))
+	}
+	if s.OneOf[0].Pattern != "^4" {
+		t.Errorf("expected branch schema to carry its own validations, got %+v", s.OneOf[0])
+	}
+}
+
+func TestNewSchemaConditional(t *testing.T) {
+	predicate := &expr.AttributeExpr{Type: expr.Object{}, Validation: &expr.ValidationExpr{Pattern: "email"}}
+	then := &expr.AttributeExpr{Type: expr.Object{}, Validation: &expr.ValidationExpr{Format: expr.FormatEmail}}
+	els := &expr.AttributeExpr{Type: expr.Object{}, Validation: &expr.ValidationExpr{Format: expr.FormatURI}}
+	att := &expr.AttributeExpr{
+		Type: expr.Object{},
+		Validation: &expr.ValidationExpr{
+			Conditionals: []*expr.ConditionalExpr{{Predicate: predicate, Then: then, Else: els}},
+		},
+	}
+	s := NewSchema(att)
+	if s.If == nil || s.If.Pattern != "email" {
+		t.Fatalf("expected if schema to carry the predicate's validations, got %+v", s.If)
+	}
+	if s.Then == nil || s.Then.Format != string(expr.FormatEmail) {
+		t.Fatalf("expected then schema to carry the Then branch's validations, got %+v", s.Then)
+	}
+	if s.Else == nil || s.Else.Format != string(expr.FormatURI) {
+		t.Fatalf("expected else schema to carry the Else branch's validations, got %+v", s.Else)
+	}
+}
+
+func TestNewSchemaNil(t *testing.T) {
+	s := NewSchema(&expr.AttributeExpr{Type: expr.String})
+	if s.MultipleOf != nil || s.UniqueItems {
+		t.Errorf("expected zero-value schema for an attribute without validations, got %+v", s)
+	}
+}
@@ -0,0 +1,51 @@
+// Package goa provides the runtime support used by services generated from a
+// goa design, including structured validation errors.
+package goa
+
+import (
+	"fmt"
+	"strings"
+
+	"goa.design/goa/expr"
+)
+
+// ValidationError is the structured error returned by generated validation
+// code in place of an opaque fmt.Errorf string: Kind identifies which
+// validator failed (e.g. "minimum", "pattern", "format"), Field the name of
+// the attribute that failed it, and Params the values needed to render the
+// message (e.g. {"min": 10, "actual": 3}).
+type ValidationError struct {
+	Kind   string
+	Field  string
+	Params map[string]interface{}
+}
+
+// Error renders the English default message, see Localize.
+func (e *ValidationError) Error() string { return e.Localize(nil, "") }
+
+// LocaleCatalog looks up the message template for a validation Kind in a
+// given locale so callers can plug in their own i18n backend. Message
+// returns "" if it has no translation for kind in locale, in which case
+// Localize falls back to the default English message.
+type LocaleCatalog interface {
+	Message(kind, locale string) string
+}
+
+// Localize renders e's message: it looks up the template for e.Kind from
+// catalog (falling back to expr.DefaultMessages if catalog is nil or has no
+// translation for locale) and substitutes the {field} token with e.Field and
+// any {name} token with the matching entry of e.Params.
+func (e *ValidationError) Localize(catalog LocaleCatalog, locale string) string {
+	tmpl := ""
+	if catalog != nil {
+		tmpl = catalog.Message(e.Kind, locale)
+	}
+	if tmpl == "" {
+		tmpl = expr.DefaultMessages[e.Kind]
+	}
+	msg := strings.ReplaceAll(tmpl, "{field}", e.Field)
+	for k, v := range e.Params {
+		msg = strings.ReplaceAll(msg, "{"+k+"}", fmt.Sprintf("%v", v))
+	}
+	return msg
+}
@@ -0,0 +1,45 @@
+package goa
+
+import "testing"
+
+type stubCatalog struct{ messages map[string]string }
+
+func (c stubCatalog) Message(kind, locale string) string {
+	return c.messages[kind+":"+locale]
+}
+
+func TestValidationErrorLocalizeDefault(t *testing.T) {
+	err := &ValidationError{Kind: "minimum", Field: "age", Params: map[string]interface{}{"min": 18}}
+	got := err.Localize(nil, "")
+	want := "age must be greater than or equal to 18"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidationErrorLocalizeCatalog(t *testing.T) {
+	catalog := stubCatalog{messages: map[string]string{"minimum:fr": "{field} doit être au moins {min}"}}
+	err := &ValidationError{Kind: "minimum", Field: "age", Params: map[string]interface{}{"min": 18}}
+	got := err.Localize(catalog, "fr")
+	want := "age doit être au moins 18"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidationErrorLocalizeCatalogMiss(t *testing.T) {
+	catalog := stubCatalog{messages: map[string]string{}}
+	err := &ValidationError{Kind: "required", Field: "name"}
+	got := err.Localize(catalog, "fr")
+	want := "name is required"
+	if got != want {
+		t.Errorf("got %q, want %q (expected fallback to the default English message)", got, want)
+	}
+}
+
+func TestValidationErrorError(t *testing.T) {
+	err := &ValidationError{Kind: "required", Field: "name"}
+	if err.Error() != "name is required" {
+		t.Errorf("got %q", err.Error())
+	}
+}